@@ -31,7 +31,7 @@ func main() {
 	user := User{Name: "John Doe"}
 
 	// Save to database
-	result, err := mongoDB.SaveOne(handler.DB, collectionName, user)
+	result, err := mongoDB.SaveOne(handler, collectionName, user)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -40,7 +40,7 @@ func main() {
 
 	// Find user
 	filter := bson.D{{Key: "name", Value: "John Doe"}}
-	foundUser, err := mongoDB.FindOne[User](handler.DB, collectionName, filter)
+	foundUser, err := mongoDB.FindOne[User](handler, collectionName, filter)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -48,7 +48,7 @@ func main() {
 
 	// Get list of users
 	sortModel := bson.D{{Key: "name", Value: 1}}
-	users, err := mongoDB.Find[User](handler.DB, collectionName, sortModel, bson.D{}, 0, 10)
+	users, err := mongoDB.Find[User](handler, collectionName, sortModel, bson.D{}, 0, 10)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,166 @@
+package mongoDB
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// BulkInserterOption configures a BulkInserter created via NewBulkInserter.
+type BulkInserterOption func(*bulkInserterConfig)
+
+type bulkInserterConfig struct {
+	batchSize     int
+	flushInterval time.Duration
+	ordered       bool
+}
+
+// WithBatchSize sets the number of buffered documents that triggers an
+// automatic flush. Defaults to 100.
+func WithBatchSize(n int) BulkInserterOption {
+	return func(c *bulkInserterConfig) {
+		c.batchSize = n
+	}
+}
+
+// WithFlushInterval sets the maximum time a document waits in the
+// buffer before being flushed. Defaults to one second.
+func WithFlushInterval(d time.Duration) BulkInserterOption {
+	return func(c *bulkInserterConfig) {
+		c.flushInterval = d
+	}
+}
+
+// WithOrdered controls whether the underlying BulkWrite is ordered.
+// Defaults to false.
+func WithOrdered(ordered bool) BulkInserterOption {
+	return func(c *bulkInserterConfig) {
+		c.ordered = ordered
+	}
+}
+
+// BulkInserter batches Insert calls for a collection and flushes them
+// with a single BulkWrite, either every batchSize documents or every
+// flushInterval, whichever comes first.
+type BulkInserter[T Document] struct {
+	handler        *MongoHandler
+	collectionName string
+	config         bulkInserterConfig
+
+	// ResultHandler, if set, is called after every flush with the
+	// BulkWrite result and error.
+	ResultHandler func(*mongo.BulkWriteResult, error)
+
+	mu       sync.Mutex
+	pending  []mongo.WriteModel
+	timer    *time.Timer
+	closed   bool
+	flushing bool
+}
+
+// NewBulkInserter creates a BulkInserter for the named collection on
+// the handler's database. The collection is re-resolved from the
+// handler on every flush, so a long-lived BulkInserter keeps working
+// after the handler reconnects.
+func NewBulkInserter[T Document](h *MongoHandler, collectionName string, opts ...BulkInserterOption) *BulkInserter[T] {
+	config := bulkInserterConfig{
+		batchSize:     100,
+		flushInterval: time.Second,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return &BulkInserter[T]{
+		handler:        h,
+		collectionName: collectionName,
+		config:         config,
+	}
+}
+
+// Insert buffers doc for the next flush, flushing immediately once the
+// buffer reaches the configured batch size.
+func (b *BulkInserter[T]) Insert(doc T) {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+
+	b.pending = append(b.pending, mongo.NewInsertOneModel().SetDocument(doc))
+	if b.timer == nil {
+		b.timer = time.AfterFunc(b.config.flushInterval, b.Flush)
+	}
+	shouldFlush := len(b.pending) >= b.config.batchSize
+	b.mu.Unlock()
+
+	if shouldFlush {
+		b.Flush()
+	}
+}
+
+// Flush writes any buffered documents immediately. It returns without
+// doing anything if a flush is already in flight, so a size-triggered
+// flush from Insert and a concurrent timer-driven or caller-driven Flush
+// never run the same batch twice.
+func (b *BulkInserter[T]) Flush() {
+	b.mu.Lock()
+	if b.flushing {
+		b.mu.Unlock()
+		return
+	}
+	if len(b.pending) == 0 {
+		if b.timer != nil {
+			b.timer.Stop()
+			b.timer = nil
+		}
+		b.mu.Unlock()
+		return
+	}
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+
+	models := b.pending
+	b.pending = nil
+	b.flushing = true
+	b.mu.Unlock()
+
+	b.doFlush(models)
+
+	b.mu.Lock()
+	b.flushing = false
+	b.mu.Unlock()
+}
+
+// doFlush performs the actual BulkWrite. It must be called without
+// b.mu held, since the network round trip should never block Insert.
+func (b *BulkInserter[T]) doFlush(models []mongo.WriteModel) {
+	ctx, cancel := context.WithTimeout(context.Background(), b.handler.config.ExecTimeout)
+	defer cancel()
+
+	bulkOpts := options.BulkWrite().SetOrdered(b.config.ordered)
+	collection := b.handler.database().Collection(b.collectionName)
+	var result *mongo.BulkWriteResult
+	err := trackSlow("BulkInserter.Flush", b.collectionName, len(models), func() error {
+		var err error
+		result, err = collection.BulkWrite(ctx, models, bulkOpts)
+		return err
+	})
+	if b.ResultHandler != nil {
+		b.ResultHandler(result, err)
+	}
+}
+
+// Close flushes any remaining buffered documents and stops the
+// background flush timer. The BulkInserter must not be used afterward.
+func (b *BulkInserter[T]) Close() {
+	b.mu.Lock()
+	b.closed = true
+	b.mu.Unlock()
+	b.Flush()
+}
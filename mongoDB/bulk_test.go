@@ -0,0 +1,92 @@
+package mongoDB
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type bulkDoc struct {
+	ID   primitive.ObjectID `bson:"_id,omitempty"`
+	Name string             `bson:"name"`
+}
+
+func (d bulkDoc) GetID() primitive.ObjectID {
+	return d.ID
+}
+
+func TestNewBulkInserterDefaults(t *testing.T) {
+	b := NewBulkInserter[bulkDoc](nil, "widgets")
+
+	if got, want := b.config.batchSize, 100; got != want {
+		t.Errorf("default batchSize = %d, want %d", got, want)
+	}
+	if got, want := b.config.flushInterval, time.Second; got != want {
+		t.Errorf("default flushInterval = %v, want %v", got, want)
+	}
+	if b.config.ordered {
+		t.Error("default ordered = true, want false")
+	}
+}
+
+func TestBulkInserterOptionsOverrideDefaults(t *testing.T) {
+	b := NewBulkInserter[bulkDoc](nil, "widgets",
+		WithBatchSize(5),
+		WithFlushInterval(2*time.Second),
+		WithOrdered(true),
+	)
+
+	if got, want := b.config.batchSize, 5; got != want {
+		t.Errorf("batchSize = %d, want %d", got, want)
+	}
+	if got, want := b.config.flushInterval, 2*time.Second; got != want {
+		t.Errorf("flushInterval = %v, want %v", got, want)
+	}
+	if !b.config.ordered {
+		t.Error("ordered = false, want true")
+	}
+}
+
+// TestBulkInserterInsertBuffersBelowBatchSize verifies Insert only buffers
+// documents and never reaches doFlush's BulkWrite call while the pending
+// count stays under the configured batch size. A long flush interval
+// keeps the background timer from firing during the test.
+func TestBulkInserterInsertBuffersBelowBatchSize(t *testing.T) {
+	b := NewBulkInserter[bulkDoc](nil, "widgets", WithBatchSize(3), WithFlushInterval(time.Hour))
+	defer func() {
+		b.mu.Lock()
+		if b.timer != nil {
+			b.timer.Stop()
+		}
+		b.mu.Unlock()
+	}()
+
+	b.Insert(bulkDoc{Name: "a"})
+	b.Insert(bulkDoc{Name: "b"})
+
+	b.mu.Lock()
+	pending := len(b.pending)
+	b.mu.Unlock()
+
+	if pending != 2 {
+		t.Errorf("pending documents = %d, want 2 (should not have auto-flushed below batch size)", pending)
+	}
+}
+
+func TestBulkInserterInsertAfterCloseIsNoOp(t *testing.T) {
+	b := NewBulkInserter[bulkDoc](nil, "widgets", WithBatchSize(3), WithFlushInterval(time.Hour))
+	b.mu.Lock()
+	b.closed = true
+	b.mu.Unlock()
+
+	b.Insert(bulkDoc{Name: "a"})
+
+	b.mu.Lock()
+	pending := len(b.pending)
+	b.mu.Unlock()
+
+	if pending != 0 {
+		t.Errorf("pending documents after Insert on closed inserter = %d, want 0", pending)
+	}
+}
@@ -9,77 +9,170 @@ package mongoDB
 //     GetID() primitive.ObjectID
 // }
 
-// MongoHandler represents a MongoDB connection handler.
-// It contains a reference to the MongoDB database.
+// Config controls connection behavior for a MongoHandler: timeouts for
+// individual operations, and the background reconnect loop that watches
+// for unexpected disconnects.
+// type Config struct {
+//     ConnectTimeout       time.Duration
+//     ExecTimeout          time.Duration
+//     ReconnectInterval    time.Duration
+//     MaxReconnectAttempts int
+//     MaxReconnectDuration time.Duration
+//     AppName              string
+// }
+
+// MongoHandler owns a MongoDB connection and transparently rebuilds it
+// on unexpected disconnects. Helper functions take the handler itself
+// rather than a *mongo.Database, so they always see the current client
+// even after a reconnect.
 // type MongoHandler struct {
-//     DB *mongo.Database
+//     OnReconnect func(err error)
 // }
 
-// NewMongoHandler creates a new MongoDB connection handler.
-// It takes database name and MongoDB URL as parameters.
-// Returns a handler and error if connection fails.
-// func NewMongoHandler(dbName, url string) (*MongoHandler, error)
+// NewMongoHandler creates a new MongoDB connection handler and starts
+// its background reconnect loop. It takes the database name, MongoDB
+// URL and an optional Config. Returns a handler and error if the
+// initial connection fails.
+// func NewMongoHandler(dbName, url string, cfg ...Config) (*MongoHandler, error)
+
+// Healthy reports whether the handler's last ping succeeded.
+// func (h *MongoHandler) Healthy() bool
 
 // CreateIndex creates an index in the specified collection.
 // Parameters:
-//   - db: MongoDB database reference
+//   - h: MongoDB connection handler
 //   - collectionName: name of the collection
 //   - model: index model in BSON format
-// func CreateIndex(db *mongo.Database, collectionName string, model bson.D) error
+// func CreateIndex(h *MongoHandler, collectionName string, model bson.D) error
 
 // SaveOne saves a single document to the specified collection.
 // Parameters:
-//   - db: MongoDB database reference
+//   - h: MongoDB connection handler
 //   - collectionName: name of the collection
 //   - doc: document to save
 // Returns InsertOneResult and error if operation fails.
-// func SaveOne[T Document](db *mongo.Database, collectionName string, doc T) (*mongo.InsertOneResult, error)
+// func SaveOne[T Document](h *MongoHandler, collectionName string, doc T) (*mongo.InsertOneResult, error)
+
+// SaveOneCtx is the context-aware variant of SaveOne, for use inside a
+// transaction started with (*MongoHandler).WithTransaction.
+// func SaveOneCtx[T Document](ctx context.Context, h *MongoHandler, collectionName string, doc T) (*mongo.InsertOneResult, error)
 
 // SaveMany saves multiple documents to the specified collection.
 // Parameters:
-//   - db: MongoDB database reference
+//   - h: MongoDB connection handler
 //   - collectionName: name of the collection
 //   - docs: slice of documents to save
 // Returns InsertManyResult and error if operation fails.
-// func SaveMany[T Document](db *mongo.Database, collectionName string, docs []T) (*mongo.InsertManyResult, error)
+// func SaveMany[T Document](h *MongoHandler, collectionName string, docs []T) (*mongo.InsertManyResult, error)
+
+// SaveManyCtx is the context-aware variant of SaveMany, for use inside a
+// transaction started with (*MongoHandler).WithTransaction.
+// func SaveManyCtx[T Document](ctx context.Context, h *MongoHandler, collectionName string, docs []T) (*mongo.InsertManyResult, error)
 
 // UpdateOne updates a single document in the specified collection.
 // The document is identified by its ID.
 // Parameters:
-//   - db: MongoDB database reference
+//   - h: MongoDB connection handler
 //   - collectionName: name of the collection
 //   - doc: document with updated fields
 // Returns UpdateResult and error if operation fails.
-// func UpdateOne[T Document](db *mongo.Database, collectionName string, doc T) (*mongo.UpdateResult, error)
+// func UpdateOne[T Document](h *MongoHandler, collectionName string, doc T) (*mongo.UpdateResult, error)
+
+// UpdateOneCtx is the context-aware variant of UpdateOne, for use inside
+// a transaction started with (*MongoHandler).WithTransaction.
+// func UpdateOneCtx[T Document](ctx context.Context, h *MongoHandler, collectionName string, doc T) (*mongo.UpdateResult, error)
 
 // FindOne finds a single document in the specified collection.
 // Parameters:
-//   - db: MongoDB database reference
+//   - h: MongoDB connection handler
 //   - collectionName: name of the collection
 //   - filter: query filter in BSON format
 // Returns found document and error if operation fails.
-// func FindOne[T Document](db *mongo.Database, collectionName string, filter bson.D) (*T, error)
+// func FindOne[T Document](h *MongoHandler, collectionName string, filter bson.D) (*T, error)
+
+// FindOneCtx is the context-aware variant of FindOne, for use inside a
+// transaction started with (*MongoHandler).WithTransaction.
+// func FindOneCtx[T Document](ctx context.Context, h *MongoHandler, collectionName string, filter bson.D) (*T, error)
 
 // DeleteOne deletes a single document by its ID.
 // Parameters:
-//   - db: MongoDB database reference
+//   - h: MongoDB connection handler
 //   - collectionName: name of the collection
 //   - id: ObjectID of the document to delete
 // Returns DeleteResult and error if operation fails.
-// func DeleteOne[T Document](db *mongo.Database, collectionName string, id primitive.ObjectID) (*mongo.DeleteResult, error)
+// func DeleteOne[T Document](h *MongoHandler, collectionName string, id primitive.ObjectID) (*mongo.DeleteResult, error)
+
+// DeleteOneCtx is the context-aware variant of DeleteOne, for use inside
+// a transaction started with (*MongoHandler).WithTransaction.
+// func DeleteOneCtx[T Document](ctx context.Context, h *MongoHandler, collectionName string, id primitive.ObjectID) (*mongo.DeleteResult, error)
 
 // Find finds multiple documents in the specified collection.
 // Parameters:
-//   - db: MongoDB database reference
+//   - h: MongoDB connection handler
 //   - collectionName: name of the collection
 //   - sortModel: sorting criteria in BSON format
 //   - filter: query filter in BSON format
 //   - skip: number of documents to skip
 //   - limit: maximum number of documents to return
 // Returns slice of found documents and error if operation fails.
-// func Find[T Document](db *mongo.Database, collectionName string, sortModel bson.D, filter bson.D, skip int64, limit int64) (*[]T, error)
+// func Find[T Document](h *MongoHandler, collectionName string, sortModel bson.D, filter bson.D, skip int64, limit int64) (*[]T, error)
+
+// FindCtx is the context-aware variant of Find, for use inside a
+// transaction started with (*MongoHandler).WithTransaction.
+// func FindCtx[T Document](ctx context.Context, h *MongoHandler, collectionName string, sortModel bson.D, filter bson.D, skip int64, limit int64) (*[]T, error)
 
-// Close closes the database connection.
-// Should be called when the handler is no longer needed.
+// WithTransaction runs fn inside a MongoDB multi-document transaction,
+// retrying per the driver's recommended loop on TransientTransactionError
+// and UnknownTransactionCommitResult.
+// func (h *MongoHandler) WithTransaction(ctx context.Context, fn func(sessCtx mongo.SessionContext) error, opts ...*options.TransactionOptions) error
+
+// BeforeInserter, AfterInserter, BeforeUpdater, AfterUpdater,
+// BeforeDeleter, AfterDeleter and AfterFinder are optional companion
+// interfaces that SaveOne, SaveMany, UpdateOne, FindOne, Find and
+// DeleteOne type-assert against and invoke around the underlying driver
+// call.
+// type BeforeInserter interface { BeforeInsert(ctx context.Context) error }
+// type AfterInserter interface { AfterInsert(ctx context.Context) error }
+// type BeforeUpdater interface { BeforeUpdate(ctx context.Context) error }
+// type AfterUpdater interface { AfterUpdate(ctx context.Context) error }
+// type BeforeDeleter interface { BeforeDelete(ctx context.Context) error }
+// type AfterDeleter interface { AfterDelete(ctx context.Context) error }
+// type AfterFinder interface { AfterFind(ctx context.Context) error }
+
+// DefaultFields is an embeddable struct that adds CreatedAt/UpdatedAt
+// bookkeeping to a Document, populated automatically by SaveOne,
+// SaveMany and UpdateOne. UpdateOne replaces the whole document via
+// $set, so it restores CreatedAt from the stored document first when
+// the update doc's own CreatedAt is still zero.
+// type DefaultFields struct { CreatedAt time.Time; UpdatedAt time.Time }
+
+// Collection returns a chainable Query builder scoped to the named
+// collection, supporting Filter/Sort/Skip/Limit/Project/Hint followed by
+// a terminal One, All, Count, Distinct, Cursor, Update or Aggregate.
+// func Collection[T Document](h *MongoHandler, collectionName string) *Query[T]
+
+// NewBulkInserter creates a BulkInserter that batches Insert calls for a
+// collection and flushes them with a single BulkWrite, either every
+// WithBatchSize documents or every WithFlushInterval duration.
+// func NewBulkInserter[T Document](h *MongoHandler, collectionName string, opts ...BulkInserterOption) *BulkInserter[T]
+
+// Close stops the background reconnect loop and closes the database
+// connection. Should be called when the handler is no longer needed.
 // Returns error if disconnection fails.
 // func (h *MongoHandler) Close() error
+
+// SetSlowThreshold sets the elapsed-time threshold above which helper
+// operations, Query's One/All/Cursor/Count/Distinct/Update/Aggregate and
+// BulkInserter's flush are reported to the logger configured via
+// SetLogger. A zero duration disables slow-query logging, the default.
+// func SetSlowThreshold(d time.Duration)
+
+// SetLogger sets the function invoked for operations that exceed the
+// slow threshold. Pass nil to stop logging.
+// func SetLogger(fn LogFunc)
+
+// SetTracer configures an OpenTelemetry tracer used to emit a span for
+// every MongoDB command issued by handlers created afterward via
+// NewMongoHandler, through the driver's command monitor. Pass nil to
+// disable tracing, which is the default.
+// func SetTracer(t trace.Tracer)
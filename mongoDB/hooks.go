@@ -0,0 +1,151 @@
+package mongoDB
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// BeforeInserter is implemented by documents that need to run logic
+// immediately before they are written by SaveOne or SaveMany.
+type BeforeInserter interface {
+	BeforeInsert(ctx context.Context) error
+}
+
+// AfterInserter is implemented by documents that need to run logic
+// immediately after they are written by SaveOne or SaveMany.
+type AfterInserter interface {
+	AfterInsert(ctx context.Context) error
+}
+
+// BeforeUpdater is implemented by documents that need to run logic
+// immediately before they are written by UpdateOne.
+type BeforeUpdater interface {
+	BeforeUpdate(ctx context.Context) error
+}
+
+// AfterUpdater is implemented by documents that need to run logic
+// immediately after they are written by UpdateOne.
+type AfterUpdater interface {
+	AfterUpdate(ctx context.Context) error
+}
+
+// BeforeDeleter is implemented by documents that need to run logic
+// immediately before they are removed by DeleteOne.
+type BeforeDeleter interface {
+	BeforeDelete(ctx context.Context) error
+}
+
+// AfterDeleter is implemented by documents that need to run logic
+// immediately after they are removed by DeleteOne.
+type AfterDeleter interface {
+	AfterDelete(ctx context.Context) error
+}
+
+// AfterFinder is implemented by documents that need to run logic
+// immediately after they are decoded by FindOne or Find.
+type AfterFinder interface {
+	AfterFind(ctx context.Context) error
+}
+
+// idSetter is implemented by documents whose ObjectID the library may
+// assign. SaveOne and SaveMany generate one automatically whenever
+// GetID().IsZero() and the document opts in by implementing this
+// interface, typically via a pointer-receiver SetID method.
+type idSetter interface {
+	SetID(id primitive.ObjectID)
+}
+
+// timestamper is implemented by documents embedding DefaultFields.
+type timestamper interface {
+	SetCreatedAt(t time.Time)
+	SetUpdatedAt(t time.Time)
+}
+
+// createdAtGetter is implemented by documents embedding DefaultFields.
+// UpdateOneCtx uses it to tell whether the caller already carried the
+// original CreatedAt into the update doc.
+type createdAtGetter interface {
+	GetCreatedAt() time.Time
+}
+
+// DefaultFields is an embeddable struct that adds CreatedAt/UpdatedAt
+// bookkeeping to a Document. SaveOne and SaveMany populate both fields
+// on insert, and UpdateOne refreshes UpdatedAt, whenever the document
+// embeds DefaultFields.
+type DefaultFields struct {
+	CreatedAt time.Time `bson:"createdAt"`
+	UpdatedAt time.Time `bson:"updatedAt"`
+}
+
+// SetCreatedAt implements timestamper.
+func (f *DefaultFields) SetCreatedAt(t time.Time) {
+	f.CreatedAt = t
+}
+
+// SetUpdatedAt implements timestamper.
+func (f *DefaultFields) SetUpdatedAt(t time.Time) {
+	f.UpdatedAt = t
+}
+
+// GetCreatedAt implements createdAtGetter.
+func (f *DefaultFields) GetCreatedAt() time.Time {
+	return f.CreatedAt
+}
+
+// applyInsertDefaults assigns a fresh ObjectID and CreatedAt/UpdatedAt
+// timestamps to doc when it opts in via idSetter/timestamper, then runs
+// BeforeInsert if doc implements BeforeInserter.
+func applyInsertDefaults(ctx context.Context, doc any) error {
+	if setter, ok := doc.(idSetter); ok {
+		if d, ok := doc.(Document); ok && d.GetID().IsZero() {
+			setter.SetID(primitive.NewObjectID())
+		}
+	}
+	if ts, ok := doc.(timestamper); ok {
+		now := time.Now()
+		ts.SetCreatedAt(now)
+		ts.SetUpdatedAt(now)
+	}
+	if hook, ok := doc.(BeforeInserter); ok {
+		return hook.BeforeInsert(ctx)
+	}
+	return nil
+}
+
+// runAfterInsert runs AfterInsert if doc implements AfterInserter.
+func runAfterInsert(ctx context.Context, doc any) error {
+	if hook, ok := doc.(AfterInserter); ok {
+		return hook.AfterInsert(ctx)
+	}
+	return nil
+}
+
+// applyUpdateDefaults refreshes UpdatedAt when doc embeds DefaultFields,
+// then runs BeforeUpdate if doc implements BeforeUpdater.
+func applyUpdateDefaults(ctx context.Context, doc any) error {
+	if ts, ok := doc.(timestamper); ok {
+		ts.SetUpdatedAt(time.Now())
+	}
+	if hook, ok := doc.(BeforeUpdater); ok {
+		return hook.BeforeUpdate(ctx)
+	}
+	return nil
+}
+
+// runAfterUpdate runs AfterUpdate if doc implements AfterUpdater.
+func runAfterUpdate(ctx context.Context, doc any) error {
+	if hook, ok := doc.(AfterUpdater); ok {
+		return hook.AfterUpdate(ctx)
+	}
+	return nil
+}
+
+// runAfterFind runs AfterFind if doc implements AfterFinder.
+func runAfterFind(ctx context.Context, doc any) error {
+	if hook, ok := doc.(AfterFinder); ok {
+		return hook.AfterFind(ctx)
+	}
+	return nil
+}
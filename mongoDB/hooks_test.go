@@ -0,0 +1,147 @@
+package mongoDB
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type hookDoc struct {
+	DefaultFields `bson:",inline"`
+
+	ID       primitive.ObjectID `bson:"_id,omitempty"`
+	Name     string             `bson:"name"`
+	events   []string
+	hookErrs map[string]error
+}
+
+func (d *hookDoc) GetID() primitive.ObjectID {
+	return d.ID
+}
+
+func (d *hookDoc) SetID(id primitive.ObjectID) {
+	d.ID = id
+}
+
+func (d *hookDoc) record(name string) error {
+	d.events = append(d.events, name)
+	return d.hookErrs[name]
+}
+
+func (d *hookDoc) BeforeInsert(ctx context.Context) error { return d.record("BeforeInsert") }
+func (d *hookDoc) AfterInsert(ctx context.Context) error  { return d.record("AfterInsert") }
+func (d *hookDoc) BeforeUpdate(ctx context.Context) error { return d.record("BeforeUpdate") }
+func (d *hookDoc) AfterUpdate(ctx context.Context) error  { return d.record("AfterUpdate") }
+func (d *hookDoc) AfterFind(ctx context.Context) error    { return d.record("AfterFind") }
+
+func TestApplyInsertDefaults(t *testing.T) {
+	doc := &hookDoc{}
+	if err := applyInsertDefaults(context.Background(), doc); err != nil {
+		t.Fatalf("applyInsertDefaults() error = %v", err)
+	}
+
+	if doc.GetID().IsZero() {
+		t.Error("applyInsertDefaults() did not assign an ObjectID")
+	}
+	if doc.CreatedAt.IsZero() || doc.UpdatedAt.IsZero() {
+		t.Error("applyInsertDefaults() did not populate CreatedAt/UpdatedAt")
+	}
+	if got, want := doc.events, []string{"BeforeInsert"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("applyInsertDefaults() hooks run = %v, want %v", got, want)
+	}
+}
+
+func TestApplyInsertDefaultsKeepsExistingID(t *testing.T) {
+	existing := primitive.NewObjectID()
+	doc := &hookDoc{ID: existing}
+	if err := applyInsertDefaults(context.Background(), doc); err != nil {
+		t.Fatalf("applyInsertDefaults() error = %v", err)
+	}
+	if doc.GetID() != existing {
+		t.Errorf("applyInsertDefaults() overwrote existing ID: got %v, want %v", doc.GetID(), existing)
+	}
+}
+
+func TestApplyInsertDefaultsPropagatesHookError(t *testing.T) {
+	wantErr := errors.New("before insert failed")
+	doc := &hookDoc{hookErrs: map[string]error{"BeforeInsert": wantErr}}
+	if err := applyInsertDefaults(context.Background(), doc); err != wantErr {
+		t.Errorf("applyInsertDefaults() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestApplyUpdateDefaultsRefreshesUpdatedAtOnly(t *testing.T) {
+	createdAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	doc := &hookDoc{}
+	doc.CreatedAt = createdAt
+
+	if err := applyUpdateDefaults(context.Background(), doc); err != nil {
+		t.Fatalf("applyUpdateDefaults() error = %v", err)
+	}
+
+	if doc.CreatedAt != createdAt {
+		t.Errorf("applyUpdateDefaults() changed CreatedAt: got %v, want %v", doc.CreatedAt, createdAt)
+	}
+	if doc.UpdatedAt.IsZero() {
+		t.Error("applyUpdateDefaults() did not refresh UpdatedAt")
+	}
+	if got, want := doc.events, []string{"BeforeUpdate"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("applyUpdateDefaults() hooks run = %v, want %v", got, want)
+	}
+}
+
+func TestRunAfterHooksSkipWhenUnimplemented(t *testing.T) {
+	type plain struct{}
+	var p plain
+
+	if err := runAfterInsert(context.Background(), &p); err != nil {
+		t.Errorf("runAfterInsert() on non-implementer error = %v, want nil", err)
+	}
+	if err := runAfterUpdate(context.Background(), &p); err != nil {
+		t.Errorf("runAfterUpdate() on non-implementer error = %v, want nil", err)
+	}
+	if err := runAfterFind(context.Background(), &p); err != nil {
+		t.Errorf("runAfterFind() on non-implementer error = %v, want nil", err)
+	}
+}
+
+func TestRunAfterHooksInvokeImplementer(t *testing.T) {
+	doc := &hookDoc{}
+
+	if err := runAfterInsert(context.Background(), doc); err != nil {
+		t.Fatalf("runAfterInsert() error = %v", err)
+	}
+	if err := runAfterUpdate(context.Background(), doc); err != nil {
+		t.Fatalf("runAfterUpdate() error = %v", err)
+	}
+	if err := runAfterFind(context.Background(), doc); err != nil {
+		t.Fatalf("runAfterFind() error = %v", err)
+	}
+
+	want := []string{"AfterInsert", "AfterUpdate", "AfterFind"}
+	if len(doc.events) != len(want) {
+		t.Fatalf("hooks run = %v, want %v", doc.events, want)
+	}
+	for i, name := range want {
+		if doc.events[i] != name {
+			t.Errorf("hooks run = %v, want %v", doc.events, want)
+			break
+		}
+	}
+}
+
+func TestDefaultFieldsGetCreatedAt(t *testing.T) {
+	var f DefaultFields
+	if !f.GetCreatedAt().IsZero() {
+		t.Fatalf("GetCreatedAt() = %v, want zero value", f.GetCreatedAt())
+	}
+
+	now := time.Now()
+	f.SetCreatedAt(now)
+	if f.GetCreatedAt() != now {
+		t.Errorf("GetCreatedAt() = %v, want %v", f.GetCreatedAt(), now)
+	}
+}
@@ -2,6 +2,7 @@ package mongoDB
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -17,144 +18,298 @@ type Document interface {
 	GetID() primitive.ObjectID
 }
 
-// MongoHandler represents a MongoDB connection handler.
-// It contains a reference to the MongoDB database.
-type MongoHandler struct {
-	DB *mongo.Database
-}
-
-// NewMongoHandler creates a new MongoDB connection handler.
-// It takes database name and MongoDB URL as parameters.
-// Returns a handler and error if connection fails.
-func NewMongoHandler(dbName, url string) (*MongoHandler, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	opts := options.Client().ApplyURI(url)
-	client, err := mongo.Connect(ctx, opts)
-	if err != nil {
-		return nil, err
-	}
-
-	// Check connection
-	err = client.Ping(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return &MongoHandler{
-		DB: client.Database(dbName),
-	}, nil
-}
-
 // CreateIndex creates an index in the specified collection.
 // Parameters:
-//   - db: MongoDB database reference
+//   - h: MongoDB connection handler
 //   - collectionName: name of the collection
 //   - model: index model in BSON format
-func CreateIndex(db *mongo.Database, collectionName string, model bson.D) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func CreateIndex(h *MongoHandler, collectionName string, model bson.D) error {
+	ctx, cancel := context.WithTimeout(context.Background(), h.config.ExecTimeout)
 	defer cancel()
 
 	indexOpts := options.CreateIndexes().SetMaxTime(time.Second * 10)
-	collection := db.Collection(collectionName)
+	collection := h.database().Collection(collectionName)
 	indexModel := mongo.IndexModel{Keys: model}
-	_, err := collection.Indexes().CreateOne(ctx, indexModel, indexOpts)
-	return err
+	return trackSlow("CreateIndex", collectionName, model, func() error {
+		_, err := collection.Indexes().CreateOne(ctx, indexModel, indexOpts)
+		return err
+	})
 }
 
 // / SaveOne saves a single document to the specified collection.
 // Parameters:
-//   - db: MongoDB database reference
+//   - h: MongoDB connection handler
 //   - collectionName: name of the collection
 //   - doc: document to save
 //
 // Returns InsertOneResult and error if operation fails.
-func SaveOne[T Document](db *mongo.Database, collectionName string, doc T) (*mongo.InsertOneResult, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func SaveOne[T Document](h *MongoHandler, collectionName string, doc T) (*mongo.InsertOneResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), h.config.ExecTimeout)
 	defer cancel()
 
-	collection := db.Collection(collectionName)
-	return collection.InsertOne(ctx, doc)
+	return SaveOneCtx(ctx, h, collectionName, doc)
+}
+
+// SaveOneCtx is the context-aware variant of SaveOne.
+// Pass a mongo.SessionContext to run the insert as part of a transaction
+// started with (*MongoHandler).WithTransaction.
+//
+// Before the insert, it generates an ObjectID when GetID().IsZero() and
+// populates CreatedAt/UpdatedAt, for documents that opt in via idSetter
+// or an embedded DefaultFields, then runs BeforeInsert/AfterInsert
+// around the driver call when doc implements BeforeInserter/AfterInserter.
+func SaveOneCtx[T Document](ctx context.Context, h *MongoHandler, collectionName string, doc T) (*mongo.InsertOneResult, error) {
+	if err := applyInsertDefaults(ctx, &doc); err != nil {
+		return nil, err
+	}
+
+	collection := h.database().Collection(collectionName)
+	var result *mongo.InsertOneResult
+	err := trackSlow("SaveOne", collectionName, doc, func() error {
+		var err error
+		result, err = collection.InsertOne(ctx, doc)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := runAfterInsert(ctx, &doc); err != nil {
+		return result, err
+	}
+	return result, nil
 }
 
 // SaveMany saves multiple documents to the specified collection.
 // Parameters:
-//   - db: MongoDB database reference
+//   - h: MongoDB connection handler
 //   - collectionName: name of the collection
 //   - docs: slice of documents to save
 //
 // Returns InsertManyResult and error if operation fails.
-func SaveMany[T Document](db *mongo.Database, collectionName string, docs []T) (*mongo.InsertManyResult, error) {
+func SaveMany[T Document](h *MongoHandler, collectionName string, docs []T) (*mongo.InsertManyResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), h.config.ExecTimeout)
+	defer cancel()
+
+	return SaveManyCtx(ctx, h, collectionName, docs)
+}
+
+// SaveManyCtx is the context-aware variant of SaveMany.
+// Pass a mongo.SessionContext to run the insert as part of a transaction
+// started with (*MongoHandler).WithTransaction.
+//
+// Each document gets the same ObjectID/CreatedAt/UpdatedAt treatment and
+// BeforeInsert/AfterInsert hooks as SaveOneCtx.
+func SaveManyCtx[T Document](ctx context.Context, h *MongoHandler, collectionName string, docs []T) (*mongo.InsertManyResult, error) {
 	interfaces := make([]interface{}, len(docs))
-	for i, doc := range docs {
-		interfaces[i] = doc
+	for i := range docs {
+		if err := applyInsertDefaults(ctx, &docs[i]); err != nil {
+			return nil, err
+		}
+		interfaces[i] = docs[i]
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+	collection := h.database().Collection(collectionName)
+	var result *mongo.InsertManyResult
+	err := trackSlow("SaveMany", collectionName, len(docs), func() error {
+		var err error
+		result, err = collection.InsertMany(ctx, interfaces)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	collection := db.Collection(collectionName)
-	return collection.InsertMany(ctx, interfaces)
+	for i := range docs {
+		if err := runAfterInsert(ctx, &docs[i]); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
 }
 
 // UpdateOne updates a single document in the specified collection.
 // The document is identified by its ID.
 // Parameters:
-//   - db: MongoDB database reference
+//   - h: MongoDB connection handler
 //   - collectionName: name of the collection
 //   - doc: document with updated fields
 //
 // Returns UpdateResult and error if operation fails.
-func UpdateOne[T Document](db *mongo.Database, collectionName string, doc T) (*mongo.UpdateResult, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func UpdateOne[T Document](h *MongoHandler, collectionName string, doc T) (*mongo.UpdateResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), h.config.ExecTimeout)
 	defer cancel()
 
-	collection := db.Collection(collectionName)
-	return collection.UpdateOne(ctx,
-		bson.D{{Key: "_id", Value: doc.GetID()}},
-		bson.D{{Key: "$set", Value: doc}})
+	return UpdateOneCtx(ctx, h, collectionName, doc)
+}
+
+// UpdateOneCtx is the context-aware variant of UpdateOne.
+// Pass a mongo.SessionContext to run the update as part of a transaction
+// started with (*MongoHandler).WithTransaction.
+//
+// UpdateOneCtx replaces the whole document via $set, so it first
+// restores the existing CreatedAt for documents with an embedded
+// DefaultFields whose CreatedAt is still zero, to avoid clobbering it
+// when callers build an update doc from scratch instead of
+// round-tripping the original. It then refreshes UpdatedAt and runs
+// BeforeUpdate/AfterUpdate around the driver call when doc implements
+// BeforeUpdater/AfterUpdater.
+func UpdateOneCtx[T Document](ctx context.Context, h *MongoHandler, collectionName string, doc T) (*mongo.UpdateResult, error) {
+	collection := h.database().Collection(collectionName)
+	if err := preserveCreatedAt(ctx, collection, &doc); err != nil {
+		return nil, err
+	}
+
+	if err := applyUpdateDefaults(ctx, &doc); err != nil {
+		return nil, err
+	}
+
+	filter := bson.D{{Key: "_id", Value: doc.GetID()}}
+	var result *mongo.UpdateResult
+	err := trackSlow("UpdateOne", collectionName, filter, func() error {
+		var err error
+		result, err = collection.UpdateOne(ctx, filter, bson.D{{Key: "$set", Value: doc}})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := runAfterUpdate(ctx, &doc); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// preserveCreatedAt restores the existing CreatedAt on doc when it
+// embeds DefaultFields and its own CreatedAt is still zero, by fetching
+// it from the stored document. This guards against UpdateOneCtx's
+// full-document $set clobbering CreatedAt. If the document has no
+// stored counterpart, there is nothing to restore and doc is left
+// unchanged; any other error (timeout, network, etc.) is returned so
+// the caller fails the update instead of silently zeroing CreatedAt.
+func preserveCreatedAt(ctx context.Context, collection *mongo.Collection, doc any) error {
+	getter, ok := doc.(createdAtGetter)
+	if !ok || !getter.GetCreatedAt().IsZero() {
+		return nil
+	}
+	setter, ok := doc.(timestamper)
+	if !ok {
+		return nil
+	}
+	d, ok := doc.(Document)
+	if !ok {
+		return nil
+	}
+
+	var existing struct {
+		CreatedAt time.Time `bson:"createdAt"`
+	}
+	opts := options.FindOne().SetProjection(bson.D{{Key: "createdAt", Value: 1}})
+	filter := bson.D{{Key: "_id", Value: d.GetID()}}
+	err := collection.FindOne(ctx, filter, opts).Decode(&existing)
+	switch {
+	case err == nil:
+		setter.SetCreatedAt(existing.CreatedAt)
+		return nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil
+	default:
+		return err
+	}
 }
 
 // FindOne finds a single document in the specified collection.
 // Parameters:
-//   - db: MongoDB database reference
+//   - h: MongoDB connection handler
 //   - collectionName: name of the collection
 //   - filter: query filter in BSON format
 //
 // Returns found document and error if operation fails.
-func FindOne[T Document](db *mongo.Database, collectionName string, filter bson.D) (*T, error) {
-	var result T
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func FindOne[T Document](h *MongoHandler, collectionName string, filter bson.D) (*T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), h.config.ExecTimeout)
 	defer cancel()
 
-	collection := db.Collection(collectionName)
-	err := collection.FindOne(ctx, filter).Decode(&result)
+	return FindOneCtx[T](ctx, h, collectionName, filter)
+}
+
+// FindOneCtx is the context-aware variant of FindOne.
+// Pass a mongo.SessionContext to read as part of a transaction started
+// with (*MongoHandler).WithTransaction.
+//
+// It runs AfterFind on the decoded document when it implements AfterFinder.
+func FindOneCtx[T Document](ctx context.Context, h *MongoHandler, collectionName string, filter bson.D) (*T, error) {
+	var result T
+	collection := h.database().Collection(collectionName)
+	err := trackSlow("FindOne", collectionName, filter, func() error {
+		return collection.FindOne(ctx, filter).Decode(&result)
+	})
 	if err != nil {
 		return nil, err
 	}
+
+	if err := runAfterFind(ctx, &result); err != nil {
+		return &result, err
+	}
 	return &result, nil
 }
 
 // DeleteOne deletes a single document by its ID.
 // Parameters:
-//   - db: MongoDB database reference
+//   - h: MongoDB connection handler
 //   - collectionName: name of the collection
 //   - id: ObjectID of the document to delete
 //
 // Returns DeleteResult and error if operation fails.
-func DeleteOne[T Document](db *mongo.Database, collectionName string, id primitive.ObjectID) (*mongo.DeleteResult, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func DeleteOne[T Document](h *MongoHandler, collectionName string, id primitive.ObjectID) (*mongo.DeleteResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), h.config.ExecTimeout)
 	defer cancel()
 
-	collection := db.Collection(collectionName)
+	return DeleteOneCtx[T](ctx, h, collectionName, id)
+}
+
+// DeleteOneCtx is the context-aware variant of DeleteOne.
+// Pass a mongo.SessionContext to run the delete as part of a transaction
+// started with (*MongoHandler).WithTransaction.
+//
+// It runs BeforeDelete/AfterDelete around the driver call on a zero-value
+// T with its ID populated, for documents that implement
+// BeforeDeleter/AfterDeleter.
+func DeleteOneCtx[T Document](ctx context.Context, h *MongoHandler, collectionName string, id primitive.ObjectID) (*mongo.DeleteResult, error) {
+	var doc T
+	if setter, ok := any(&doc).(idSetter); ok {
+		setter.SetID(id)
+	}
+	if hook, ok := any(&doc).(BeforeDeleter); ok {
+		if err := hook.BeforeDelete(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	collection := h.database().Collection(collectionName)
 	opts := options.Delete().SetHint(bson.D{{Key: "_id", Value: 1}})
-	return collection.DeleteOne(ctx, bson.M{"_id": id}, opts)
+	filter := bson.M{"_id": id}
+	var result *mongo.DeleteResult
+	err := trackSlow("DeleteOne", collectionName, filter, func() error {
+		var err error
+		result, err = collection.DeleteOne(ctx, filter, opts)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if hook, ok := any(&doc).(AfterDeleter); ok {
+		if err := hook.AfterDelete(ctx); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
 }
 
 // Find finds multiple documents in the specified collection.
 // Parameters:
-//   - db: MongoDB database reference
+//   - h: MongoDB connection handler
 //   - collectionName: name of the collection
 //   - sortModel: sorting criteria in BSON format
 //   - filter: query filter in BSON format
@@ -163,39 +318,56 @@ func DeleteOne[T Document](db *mongo.Database, collectionName string, id primiti
 //
 // Returns slice of found documents and error if operation fails.
 func Find[T Document](
-	db *mongo.Database,
+	h *MongoHandler,
 	collectionName string,
 	sortModel bson.D,
 	filter bson.D,
 	skip int64,
 	limit int64,
 ) (*[]T, error) {
-	var results []T
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), h.config.ExecTimeout)
 	defer cancel()
 
+	return FindCtx[T](ctx, h, collectionName, sortModel, filter, skip, limit)
+}
+
+// FindCtx is the context-aware variant of Find.
+// Pass a mongo.SessionContext to read as part of a transaction started
+// with (*MongoHandler).WithTransaction.
+//
+// It runs AfterFind on each decoded document when it implements AfterFinder.
+func FindCtx[T Document](
+	ctx context.Context,
+	h *MongoHandler,
+	collectionName string,
+	sortModel bson.D,
+	filter bson.D,
+	skip int64,
+	limit int64,
+) (*[]T, error) {
+	var results []T
+
 	opts := options.Find().SetSort(sortModel).SetSkip(skip).SetLimit(limit)
-	collection := db.Collection(collectionName)
+	collection := h.database().Collection(collectionName)
+
+	err := trackSlow("Find", collectionName, filter, func() error {
+		cursor, err := collection.Find(ctx, filter, opts)
+		if err != nil {
+			return err
+		}
+		defer cursor.Close(ctx)
 
-	cursor, err := collection.Find(ctx, filter, opts)
+		return cursor.All(ctx, &results)
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	if err = cursor.All(ctx, &results); err != nil {
-		return nil, err
+	for i := range results {
+		if err := runAfterFind(ctx, &results[i]); err != nil {
+			return &results, err
+		}
 	}
 
 	return &results, nil
 }
-
-// Close closes the database connection.
-// Should be called when the handler is no longer needed.
-// Returns error if disconnection fails.
-func (h *MongoHandler) Close() error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	return h.DB.Client().Disconnect(ctx)
-}
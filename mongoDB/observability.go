@@ -0,0 +1,53 @@
+package mongoDB
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// LogFunc is called for every helper operation whose elapsed time
+// exceeds the threshold configured via SetSlowThreshold.
+type LogFunc func(op, coll string, filter interface{}, elapsed time.Duration, err error)
+
+var (
+	slowThreshold atomic.Int64 // nanoseconds; 0 disables slow-query logging
+	logger        atomic.Pointer[LogFunc]
+)
+
+// SetSlowThreshold sets the elapsed-time threshold above which helper
+// operations (SaveOne, Find, UpdateOne, etc.) are reported to the
+// logger configured via SetLogger. A zero duration disables slow-query
+// logging, which is the default.
+func SetSlowThreshold(d time.Duration) {
+	slowThreshold.Store(int64(d))
+}
+
+// SetLogger sets the function invoked for operations that exceed the
+// slow threshold. Pass nil to stop logging.
+func SetLogger(fn LogFunc) {
+	if fn == nil {
+		logger.Store(nil)
+		return
+	}
+	logger.Store(&fn)
+}
+
+// trackSlow times fn and, if it runs longer than the configured slow
+// threshold, reports it via the configured logger. It always returns
+// fn's error unchanged.
+func trackSlow(op, coll string, filter interface{}, fn func() error) error {
+	start := time.Now()
+	err := fn()
+
+	threshold := time.Duration(slowThreshold.Load())
+	if threshold <= 0 {
+		return err
+	}
+
+	if elapsed := time.Since(start); elapsed > threshold {
+		if logFn := logger.Load(); logFn != nil {
+			(*logFn)(op, coll, filter, elapsed, err)
+		}
+	}
+	return err
+}
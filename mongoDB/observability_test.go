@@ -0,0 +1,107 @@
+package mongoDB
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// resetSlowTracking restores the package-level slow-query state to its
+// defaults, since slowThreshold/logger are shared across tests.
+func resetSlowTracking(t *testing.T) {
+	slowThreshold.Store(0)
+	logger.Store(nil)
+	t.Cleanup(func() {
+		slowThreshold.Store(0)
+		logger.Store(nil)
+	})
+}
+
+func TestTrackSlowThresholdZeroDisablesLogging(t *testing.T) {
+	resetSlowTracking(t)
+
+	var called bool
+	SetLogger(func(op, coll string, filter interface{}, elapsed time.Duration, err error) {
+		called = true
+	})
+
+	_ = trackSlow("Op", "coll", nil, func() error { return nil })
+
+	if called {
+		t.Error("logger was called with slow threshold disabled")
+	}
+}
+
+func TestTrackSlowNotCalledBelowThreshold(t *testing.T) {
+	resetSlowTracking(t)
+
+	SetSlowThreshold(time.Hour)
+	var called bool
+	SetLogger(func(op, coll string, filter interface{}, elapsed time.Duration, err error) {
+		called = true
+	})
+
+	_ = trackSlow("Op", "coll", nil, func() error { return nil })
+
+	if called {
+		t.Error("logger was called for an operation faster than the threshold")
+	}
+}
+
+func TestTrackSlowLogsWhenOverThreshold(t *testing.T) {
+	resetSlowTracking(t)
+
+	SetSlowThreshold(time.Millisecond)
+	var gotOp, gotColl string
+	var gotFilter interface{}
+	var gotErr error
+	called := false
+	SetLogger(func(op, coll string, filter interface{}, elapsed time.Duration, err error) {
+		called = true
+		gotOp, gotColl, gotFilter, gotErr = op, coll, filter, err
+	})
+
+	wantErr := errors.New("boom")
+	_ = trackSlow("Query.One", "widgets", "the-filter", func() error {
+		time.Sleep(2 * time.Millisecond)
+		return wantErr
+	})
+
+	if !called {
+		t.Fatal("logger was not called for an operation slower than the threshold")
+	}
+	if gotOp != "Query.One" || gotColl != "widgets" || gotFilter != "the-filter" || gotErr != wantErr {
+		t.Errorf("logger got (%q, %q, %v, %v), want (%q, %q, %v, %v)",
+			gotOp, gotColl, gotFilter, gotErr, "Query.One", "widgets", "the-filter", wantErr)
+	}
+}
+
+func TestTrackSlowReturnsFnError(t *testing.T) {
+	resetSlowTracking(t)
+
+	wantErr := errors.New("boom")
+	err := trackSlow("Op", "coll", nil, func() error { return wantErr })
+	if err != wantErr {
+		t.Errorf("trackSlow() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestSetLoggerNilStopsLogging(t *testing.T) {
+	resetSlowTracking(t)
+
+	SetSlowThreshold(time.Millisecond)
+	var called bool
+	SetLogger(func(op, coll string, filter interface{}, elapsed time.Duration, err error) {
+		called = true
+	})
+	SetLogger(nil)
+
+	_ = trackSlow("Op", "coll", nil, func() error {
+		time.Sleep(2 * time.Millisecond)
+		return nil
+	})
+
+	if called {
+		t.Error("logger was called after being cleared with SetLogger(nil)")
+	}
+}
@@ -0,0 +1,241 @@
+package mongoDB
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Query is a chainable builder for MongoDB queries against a single
+// collection. Build one with Collection, chain Filter/Sort/Skip/Limit/
+// Project/Hint as needed, then call a terminal method such as One, All,
+// Count, Distinct, Cursor or Update.
+type Query[T Document] struct {
+	handler        *MongoHandler
+	collectionName string
+	filter         bson.M
+	sort           bson.D
+	skip           int64
+	limit          int64
+	projection     bson.D
+	hint           interface{}
+}
+
+// Collection returns a Query builder scoped to the named collection on
+// the handler's database. The collection is re-resolved from the
+// handler on every terminal call, so a long-lived Query keeps working
+// after the handler reconnects.
+func Collection[T Document](h *MongoHandler, collectionName string) *Query[T] {
+	return &Query[T]{
+		handler:        h,
+		collectionName: collectionName,
+		filter:         bson.M{},
+	}
+}
+
+// collection resolves the current *mongo.Collection from the handler.
+func (q *Query[T]) collection() *mongo.Collection {
+	return q.handler.database().Collection(q.collectionName)
+}
+
+// Filter sets the query filter, replacing any filter set previously.
+func (q *Query[T]) Filter(filter bson.M) *Query[T] {
+	q.filter = filter
+	return q
+}
+
+// Sort sets the sort order.
+func (q *Query[T]) Sort(sort bson.D) *Query[T] {
+	q.sort = sort
+	return q
+}
+
+// Skip sets the number of matching documents to skip.
+func (q *Query[T]) Skip(skip int64) *Query[T] {
+	q.skip = skip
+	return q
+}
+
+// Limit sets the maximum number of documents to return.
+func (q *Query[T]) Limit(limit int64) *Query[T] {
+	q.limit = limit
+	return q
+}
+
+// Project sets the fields to include or exclude in the result.
+func (q *Query[T]) Project(projection bson.D) *Query[T] {
+	q.projection = projection
+	return q
+}
+
+// Hint sets the index hint to use for the query.
+func (q *Query[T]) Hint(hint interface{}) *Query[T] {
+	q.hint = hint
+	return q
+}
+
+// One runs the query and decodes the first matching document.
+// Returns an error if no document matches.
+func (q *Query[T]) One(ctx context.Context) (*T, error) {
+	var result T
+	opts := options.FindOne().SetSort(q.sort).SetSkip(q.skip).SetProjection(q.projection).SetHint(q.hint)
+	collection := q.collection()
+	err := trackSlow("Query.One", q.collectionName, q.filter, func() error {
+		return collection.FindOne(ctx, q.filter, opts).Decode(&result)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := runAfterFind(ctx, &result); err != nil {
+		return &result, err
+	}
+	return &result, nil
+}
+
+// All runs the query and decodes every matching document.
+func (q *Query[T]) All(ctx context.Context) ([]T, error) {
+	var results []T
+	opts := q.findOptions()
+	collection := q.collection()
+	err := trackSlow("Query.All", q.collectionName, q.filter, func() error {
+		cursor, err := collection.Find(ctx, q.filter, opts)
+		if err != nil {
+			return err
+		}
+		defer cursor.Close(ctx)
+		return cursor.All(ctx, &results)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		if err := runAfterFind(ctx, &results[i]); err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+// findOptions builds the *options.FindOptions shared by Cursor and All.
+func (q *Query[T]) findOptions() *options.FindOptions {
+	return options.Find().SetSort(q.sort).SetSkip(q.skip).SetLimit(q.limit).SetProjection(q.projection).SetHint(q.hint)
+}
+
+// Cursor runs the query and returns the raw driver cursor, for callers
+// that want to stream results instead of decoding them all at once.
+func (q *Query[T]) Cursor(ctx context.Context) (*mongo.Cursor, error) {
+	opts := q.findOptions()
+	collection := q.collection()
+	var cursor *mongo.Cursor
+	err := trackSlow("Query.Cursor", q.collectionName, q.filter, func() error {
+		var err error
+		cursor, err = collection.Find(ctx, q.filter, opts)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// countOptions builds the *options.CountOptions for Count. Limit is only
+// set when the builder was given a positive limit; an unset or
+// non-positive limit is not passed on, so every matching document is
+// counted.
+func (q *Query[T]) countOptions() *options.CountOptions {
+	opts := options.Count().SetSkip(q.skip).SetHint(q.hint)
+	if q.limit > 0 {
+		opts = opts.SetLimit(q.limit)
+	}
+	return opts
+}
+
+// Count returns the number of documents matching the filter.
+func (q *Query[T]) Count(ctx context.Context) (int64, error) {
+	opts := q.countOptions()
+	collection := q.collection()
+	var count int64
+	err := trackSlow("Query.Count", q.collectionName, q.filter, func() error {
+		var err error
+		count, err = collection.CountDocuments(ctx, q.filter, opts)
+		return err
+	})
+	return count, err
+}
+
+// Distinct returns the distinct values of field among matching documents.
+func (q *Query[T]) Distinct(ctx context.Context, field string) ([]interface{}, error) {
+	collection := q.collection()
+	var values []interface{}
+	err := trackSlow("Query.Distinct", q.collectionName, q.filter, func() error {
+		var err error
+		values, err = collection.Distinct(ctx, field, q.filter)
+		return err
+	})
+	return values, err
+}
+
+// Update applies update to every document matching the filter.
+func (q *Query[T]) Update(ctx context.Context, update bson.M) (*mongo.UpdateResult, error) {
+	collection := q.collection()
+	var result *mongo.UpdateResult
+	err := trackSlow("Query.Update", q.collectionName, q.filter, func() error {
+		var err error
+		result, err = collection.UpdateMany(ctx, q.filter, update)
+		return err
+	})
+	return result, err
+}
+
+// Aggregate runs pipeline against the collection and returns a typed
+// cursor iterator that decodes into T, ignoring the builder's
+// Filter/Sort/Skip/Limit/Project/Hint (encode them as pipeline stages instead).
+func (q *Query[T]) Aggregate(ctx context.Context, pipeline mongo.Pipeline) (*AggregationCursor[T], error) {
+	collection := q.collection()
+	var cursor *mongo.Cursor
+	err := trackSlow("Query.Aggregate", q.collectionName, pipeline, func() error {
+		var err error
+		cursor, err = collection.Aggregate(ctx, pipeline)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &AggregationCursor[T]{cursor: cursor}, nil
+}
+
+// AggregationCursor iterates the results of an aggregation pipeline,
+// decoding each document into T.
+type AggregationCursor[T Document] struct {
+	cursor *mongo.Cursor
+}
+
+// Next advances the cursor and reports whether another document is
+// available.
+func (c *AggregationCursor[T]) Next(ctx context.Context) bool {
+	return c.cursor.Next(ctx)
+}
+
+// Decode decodes the current document into v.
+func (c *AggregationCursor[T]) Decode(v *T) error {
+	return c.cursor.Decode(v)
+}
+
+// All drains the cursor, decoding every remaining document into a slice.
+func (c *AggregationCursor[T]) All(ctx context.Context) ([]T, error) {
+	defer c.cursor.Close(ctx)
+	var results []T
+	if err := c.cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// Close closes the underlying cursor.
+func (c *AggregationCursor[T]) Close(ctx context.Context) error {
+	return c.cursor.Close(ctx)
+}
@@ -0,0 +1,103 @@
+package mongoDB
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestCollectionReturnsEmptyFilterBuilder(t *testing.T) {
+	q := Collection[bulkDoc](nil, "widgets")
+
+	if q.collectionName != "widgets" {
+		t.Errorf("collectionName = %q, want %q", q.collectionName, "widgets")
+	}
+	if q.filter == nil {
+		t.Error("filter = nil, want an empty bson.M")
+	}
+}
+
+func TestQueryChainSettersReturnSameBuilder(t *testing.T) {
+	q := Collection[bulkDoc](nil, "widgets")
+
+	filter := bson.M{"name": "a"}
+	sort := bson.D{{Key: "name", Value: 1}}
+	projection := bson.D{{Key: "name", Value: 1}}
+
+	got := q.Filter(filter).Sort(sort).Skip(5).Limit(10).Project(projection).Hint("name_1")
+
+	if got != q {
+		t.Fatal("chain setters did not return the same *Query")
+	}
+	if q.filter["name"] != "a" {
+		t.Errorf("filter = %v, want %v", q.filter, filter)
+	}
+	if len(q.sort) != 1 || q.sort[0].Key != "name" {
+		t.Errorf("sort = %v, want %v", q.sort, sort)
+	}
+	if q.skip != 5 {
+		t.Errorf("skip = %d, want 5", q.skip)
+	}
+	if q.limit != 10 {
+		t.Errorf("limit = %d, want 10", q.limit)
+	}
+	if len(q.projection) != 1 || q.projection[0].Key != "name" {
+		t.Errorf("projection = %v, want %v", q.projection, projection)
+	}
+	if q.hint != "name_1" {
+		t.Errorf("hint = %v, want %q", q.hint, "name_1")
+	}
+}
+
+func TestQueryFilterReplacesPreviousFilter(t *testing.T) {
+	q := Collection[bulkDoc](nil, "widgets")
+	q.Filter(bson.M{"name": "a"})
+	q.Filter(bson.M{"name": "b"})
+
+	if len(q.filter) != 1 || q.filter["name"] != "b" {
+		t.Errorf("filter = %v, want only {name: b}", q.filter)
+	}
+}
+
+func TestCountOptionsOmitsLimitWhenUnset(t *testing.T) {
+	q := Collection[bulkDoc](nil, "widgets")
+
+	opts := q.countOptions()
+
+	if opts.Limit != nil {
+		t.Errorf("Limit = %v, want nil when no Limit was set on the builder", *opts.Limit)
+	}
+}
+
+func TestCountOptionsSetsLimitWhenPositive(t *testing.T) {
+	q := Collection[bulkDoc](nil, "widgets").Limit(25)
+
+	opts := q.countOptions()
+
+	if opts.Limit == nil || *opts.Limit != 25 {
+		t.Errorf("Limit = %v, want 25", opts.Limit)
+	}
+}
+
+func TestCountOptionsOmitsLimitWhenNegative(t *testing.T) {
+	q := Collection[bulkDoc](nil, "widgets").Limit(-1)
+
+	opts := q.countOptions()
+
+	if opts.Limit != nil {
+		t.Errorf("Limit = %v, want nil for a non-positive Limit", *opts.Limit)
+	}
+}
+
+func TestFindOptionsCarryBuilderState(t *testing.T) {
+	q := Collection[bulkDoc](nil, "widgets").Skip(5).Limit(10)
+
+	opts := q.findOptions()
+
+	if opts.Skip == nil || *opts.Skip != 5 {
+		t.Errorf("Skip = %v, want 5", opts.Skip)
+	}
+	if opts.Limit == nil || *opts.Limit != 10 {
+		t.Errorf("Limit = %v, want 10", opts.Limit)
+	}
+}
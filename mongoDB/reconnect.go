@@ -0,0 +1,221 @@
+package mongoDB
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Config controls connection behavior for a MongoHandler: timeouts for
+// individual operations, and the background reconnect loop that watches
+// for unexpected disconnects.
+type Config struct {
+	// ConnectTimeout bounds the initial connection attempt and each
+	// reconnect attempt. Defaults to 10s.
+	ConnectTimeout time.Duration
+	// ExecTimeout bounds each operation issued through the package's
+	// helper functions. Defaults to 10s.
+	ExecTimeout time.Duration
+	// ReconnectInterval is how often the handler pings the server to
+	// detect disconnects. Defaults to 5s.
+	ReconnectInterval time.Duration
+	// MaxReconnectAttempts caps how many consecutive reconnect attempts
+	// are made after a failed ping, before giving up until the next
+	// ping tick. Zero means unlimited.
+	MaxReconnectAttempts int
+	// MaxReconnectDuration caps the total time spent reconnecting before
+	// giving up until the next ping tick. Zero means unlimited.
+	MaxReconnectDuration time.Duration
+	// AppName is reported to the server via the driver's AppName option.
+	AppName string
+}
+
+func (c Config) withDefaults() Config {
+	if c.ConnectTimeout <= 0 {
+		c.ConnectTimeout = 10 * time.Second
+	}
+	if c.ExecTimeout <= 0 {
+		c.ExecTimeout = 10 * time.Second
+	}
+	if c.ReconnectInterval <= 0 {
+		c.ReconnectInterval = 5 * time.Second
+	}
+	return c
+}
+
+// MongoHandler owns a MongoDB connection and transparently rebuilds it
+// on unexpected disconnects. Helper functions like SaveOne and Find take
+// the handler itself rather than a *mongo.Database, so they always see
+// the current client even after a reconnect.
+type MongoHandler struct {
+	dbName string
+	url    string
+	config Config
+
+	// OnReconnect, when set, is called after every reconnect attempt,
+	// successful or not, with the resulting error (nil on success).
+	OnReconnect func(err error)
+
+	mu      sync.RWMutex
+	db      *mongo.Database
+	healthy bool
+
+	closeOnce sync.Once
+	closeCh   chan struct{}
+}
+
+// NewMongoHandler creates a new MongoDB connection handler and starts
+// its background reconnect loop. It takes the database name, MongoDB
+// URL and an optional Config. Returns a handler and error if the
+// initial connection fails.
+func NewMongoHandler(dbName, url string, cfg ...Config) (*MongoHandler, error) {
+	config := Config{}
+	if len(cfg) > 0 {
+		config = cfg[0]
+	}
+	config = config.withDefaults()
+
+	db, err := dialMongo(dbName, url, config)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &MongoHandler{
+		dbName:  dbName,
+		url:     url,
+		config:  config,
+		db:      db,
+		healthy: true,
+		closeCh: make(chan struct{}),
+	}
+
+	go h.watch()
+
+	return h, nil
+}
+
+// dialMongo connects to url and pings it, returning the named database
+// on success.
+func dialMongo(dbName, url string, config Config) (*mongo.Database, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), config.ConnectTimeout)
+	defer cancel()
+
+	opts := options.Client().ApplyURI(url)
+	if config.AppName != "" {
+		opts = opts.SetAppName(config.AppName)
+	}
+	if t := tracer.Load(); t != nil {
+		opts = opts.SetMonitor(otelCommandMonitor(*t))
+	}
+
+	client, err := mongo.Connect(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		_ = client.Disconnect(ctx)
+		return nil, err
+	}
+
+	return client.Database(dbName), nil
+}
+
+// database returns the handler's current database under a read lock, so
+// callers always observe the latest client after a reconnect.
+func (h *MongoHandler) database() *mongo.Database {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.db
+}
+
+// Healthy reports whether the handler's last ping succeeded.
+func (h *MongoHandler) Healthy() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.healthy
+}
+
+// watch periodically pings the server and triggers reconnect on
+// failure, until the handler is closed.
+func (h *MongoHandler) watch() {
+	ticker := time.NewTicker(h.config.ReconnectInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.closeCh:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), h.config.ConnectTimeout)
+			err := h.database().Client().Ping(ctx, nil)
+			cancel()
+
+			if err == nil {
+				h.setHealthy(true)
+				continue
+			}
+
+			h.setHealthy(false)
+			h.reconnect()
+		}
+	}
+}
+
+// reconnect redials until it succeeds or the configured attempt/duration
+// budget is exhausted, reporting every attempt via OnReconnect.
+func (h *MongoHandler) reconnect() {
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		if h.config.MaxReconnectAttempts > 0 && attempt > h.config.MaxReconnectAttempts {
+			return
+		}
+		if h.config.MaxReconnectDuration > 0 && time.Since(start) > h.config.MaxReconnectDuration {
+			return
+		}
+
+		db, err := dialMongo(h.dbName, h.url, h.config)
+		if h.OnReconnect != nil {
+			h.OnReconnect(err)
+		}
+		if err == nil {
+			h.mu.Lock()
+			old := h.db
+			h.db = db
+			h.healthy = true
+			h.mu.Unlock()
+
+			disconnectCtx, cancel := context.WithTimeout(context.Background(), h.config.ConnectTimeout)
+			_ = old.Client().Disconnect(disconnectCtx)
+			cancel()
+			return
+		}
+
+		select {
+		case <-h.closeCh:
+			return
+		case <-time.After(h.config.ReconnectInterval):
+		}
+	}
+}
+
+func (h *MongoHandler) setHealthy(healthy bool) {
+	h.mu.Lock()
+	h.healthy = healthy
+	h.mu.Unlock()
+}
+
+// Close stops the background reconnect loop and closes the database
+// connection. Should be called when the handler is no longer needed.
+// Returns error if disconnection fails.
+func (h *MongoHandler) Close() error {
+	h.closeOnce.Do(func() { close(h.closeCh) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.config.ExecTimeout)
+	defer cancel()
+
+	return h.database().Client().Disconnect(ctx)
+}
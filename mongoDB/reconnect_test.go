@@ -0,0 +1,34 @@
+package mongoDB
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConfigWithDefaults(t *testing.T) {
+	got := Config{}.withDefaults()
+
+	want := Config{
+		ConnectTimeout:    10 * time.Second,
+		ExecTimeout:       10 * time.Second,
+		ReconnectInterval: 5 * time.Second,
+	}
+	if got != want {
+		t.Errorf("Config{}.withDefaults() = %+v, want %+v", got, want)
+	}
+}
+
+func TestConfigWithDefaultsPreservesExplicitValues(t *testing.T) {
+	explicit := Config{
+		ConnectTimeout:       time.Second,
+		ExecTimeout:          2 * time.Second,
+		ReconnectInterval:    3 * time.Second,
+		MaxReconnectAttempts: 5,
+		MaxReconnectDuration: time.Minute,
+		AppName:              "svc",
+	}
+
+	if got := explicit.withDefaults(); got != explicit {
+		t.Errorf("withDefaults() = %+v, want unchanged %+v", got, explicit)
+	}
+}
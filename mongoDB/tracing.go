@@ -0,0 +1,78 @@
+package mongoDB
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer atomic.Pointer[trace.Tracer]
+
+// SetTracer configures an OpenTelemetry tracer used to emit a span for
+// every MongoDB command issued by handlers created afterward via
+// NewMongoHandler. Pass nil to disable tracing, which is the default.
+func SetTracer(t trace.Tracer) {
+	if t == nil {
+		tracer.Store(nil)
+		return
+	}
+	tracer.Store(&t)
+}
+
+// commandSpanTracker correlates the driver's CommandStarted/Succeeded/
+// Failed callbacks, which only share a RequestID, so the span opened on
+// start can be closed on completion.
+type commandSpanTracker struct {
+	mu    sync.Mutex
+	spans map[int64]trace.Span
+}
+
+func (t *commandSpanTracker) store(id int64, span trace.Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.spans[id] = span
+}
+
+func (t *commandSpanTracker) take(id int64) (trace.Span, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	span, ok := t.spans[id]
+	delete(t.spans, id)
+	return span, ok
+}
+
+// otelCommandMonitor builds a driver command monitor that starts a span
+// named after each MongoDB command and ends it when the command
+// completes, recording an error status on failure.
+func otelCommandMonitor(t trace.Tracer) *event.CommandMonitor {
+	spans := &commandSpanTracker{spans: make(map[int64]trace.Span)}
+
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, evt *event.CommandStartedEvent) {
+			_, span := t.Start(ctx, "mongo."+evt.CommandName,
+				trace.WithAttributes(
+					attribute.String("db.system", "mongodb"),
+					attribute.String("db.name", evt.DatabaseName),
+					attribute.String("db.operation", evt.CommandName),
+				))
+			spans.store(evt.RequestID, span)
+		},
+		Succeeded: func(ctx context.Context, evt *event.CommandSucceededEvent) {
+			if span, ok := spans.take(evt.RequestID); ok {
+				span.End()
+			}
+		},
+		Failed: func(ctx context.Context, evt *event.CommandFailedEvent) {
+			if span, ok := spans.take(evt.RequestID); ok {
+				span.SetStatus(codes.Error, fmt.Sprint(evt.Failure))
+				span.End()
+			}
+		},
+	}
+}
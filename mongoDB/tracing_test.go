@@ -0,0 +1,54 @@
+package mongoDB
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// fakeSpan gives otherwise-identical noop spans a distinct identity, since
+// *fakeSpan is a pointer and so always comparable, unlike noop.Span values.
+type fakeSpan struct {
+	noop.Span
+	id int
+}
+
+func newTestSpan(id int) trace.Span {
+	return &fakeSpan{id: id}
+}
+
+func TestCommandSpanTrackerTakeReturnsStoredSpan(t *testing.T) {
+	tracker := &commandSpanTracker{spans: make(map[int64]trace.Span)}
+	span := newTestSpan(1)
+
+	tracker.store(1, span)
+
+	got, ok := tracker.take(1)
+	if !ok {
+		t.Fatal("take() = false, want true for a stored span")
+	}
+	if got != span {
+		t.Error("take() returned a different span than the one stored")
+	}
+}
+
+func TestCommandSpanTrackerTakeRemovesEntry(t *testing.T) {
+	tracker := &commandSpanTracker{spans: make(map[int64]trace.Span)}
+	tracker.store(1, newTestSpan(1))
+
+	if _, ok := tracker.take(1); !ok {
+		t.Fatal("take() = false on first call, want true")
+	}
+	if _, ok := tracker.take(1); ok {
+		t.Error("take() = true on second call, want false (entry should be consumed)")
+	}
+}
+
+func TestCommandSpanTrackerTakeUnknownID(t *testing.T) {
+	tracker := &commandSpanTracker{spans: make(map[int64]trace.Span)}
+
+	if _, ok := tracker.take(99); ok {
+		t.Error("take() = true for an ID that was never stored, want false")
+	}
+}
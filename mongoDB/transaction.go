@@ -0,0 +1,74 @@
+package mongoDB
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// WithTransaction runs fn inside a MongoDB multi-document transaction.
+// It starts a session on the handler's client, executes fn with a
+// mongo.SessionContext that callers should thread through to the Ctx
+// variants of SaveOne, SaveMany, UpdateOne, FindOne, Find and DeleteOne
+// so every operation inside fn is part of the same transaction, and
+// commits on success or aborts on error.
+//
+// Per the driver's recommended retry loop, the whole transaction (body
+// and commit) is retried when the error is labeled
+// TransientTransactionError, and the commit alone is retried while it
+// is labeled UnknownTransactionCommitResult, which covers transient
+// issues like a primary stepdown mid-transaction.
+func (h *MongoHandler) WithTransaction(ctx context.Context, fn func(sessCtx mongo.SessionContext) error, opts ...*options.TransactionOptions) error {
+	session, err := h.database().Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	txnOpts := options.Transaction()
+	if len(opts) > 0 && opts[0] != nil {
+		txnOpts = opts[0]
+	}
+
+	for {
+		err = mongo.WithSession(ctx, session, func(sessCtx mongo.SessionContext) error {
+			if err := session.StartTransaction(txnOpts); err != nil {
+				return err
+			}
+
+			if err := fn(sessCtx); err != nil {
+				_ = session.AbortTransaction(sessCtx)
+				return err
+			}
+
+			return commitWithRetry(sessCtx, session)
+		})
+
+		if err == nil {
+			return nil
+		}
+		if hasErrorLabel(err, "TransientTransactionError") {
+			continue
+		}
+		return err
+	}
+}
+
+// commitWithRetry commits the active transaction, retrying while the
+// server reports UnknownTransactionCommitResult.
+func commitWithRetry(sessCtx mongo.SessionContext, session mongo.Session) error {
+	for {
+		err := session.CommitTransaction(sessCtx)
+		if err == nil || !hasErrorLabel(err, "UnknownTransactionCommitResult") {
+			return err
+		}
+	}
+}
+
+// hasErrorLabel reports whether err carries the given MongoDB server
+// error label.
+func hasErrorLabel(err error, label string) bool {
+	labeled, ok := err.(mongo.ServerError)
+	return ok && labeled.HasErrorLabel(label)
+}
@@ -0,0 +1,50 @@
+package mongoDB
+
+import (
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestHasErrorLabel(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		label string
+		want  bool
+	}{
+		{
+			name:  "matching label",
+			err:   mongo.CommandError{Labels: []string{"TransientTransactionError"}},
+			label: "TransientTransactionError",
+			want:  true,
+		},
+		{
+			name:  "non-matching label",
+			err:   mongo.CommandError{Labels: []string{"TransientTransactionError"}},
+			label: "UnknownTransactionCommitResult",
+			want:  false,
+		},
+		{
+			name:  "no labels",
+			err:   mongo.CommandError{},
+			label: "TransientTransactionError",
+			want:  false,
+		},
+		{
+			name:  "not a ServerError",
+			err:   errors.New("boom"),
+			label: "TransientTransactionError",
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasErrorLabel(tt.err, tt.label); got != tt.want {
+				t.Errorf("hasErrorLabel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}